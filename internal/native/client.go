@@ -4,25 +4,22 @@ import (
 	"bytes"
 	"context"
 	"crypto/ecdsa"
-	"crypto/sha256"
 	"encoding/binary"
 	"errors"
-	"fmt"
+	"sync"
 	"time"
 
 	"github.com/dop251/goja"
 	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
-	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/bearer"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
-	"github.com/nspcc-dev/neofs-sdk-go/object/address"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/nspcc-dev/neofs-sdk-go/session"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 	"github.com/nspcc-dev/neofs-sdk-go/version"
-	"github.com/nspcc-dev/tzhash/tz"
 	"github.com/nspcc-dev/xk6-neofs/internal/stats"
 	"go.k6.io/k6/js/modules"
 	"go.k6.io/k6/metrics"
@@ -35,6 +32,15 @@ type (
 		tok     session.Object
 		cli     *client.Client
 		bufsize int
+
+		sessionMu sync.RWMutex
+		sessions  map[sessionKey]cachedSession
+
+		bearerMu    sync.RWMutex
+		bearerToken *bearer.Token
+
+		endpointMu sync.RWMutex
+		endpoint   string
 	}
 
 	PutResponse struct {
@@ -49,18 +55,24 @@ type (
 	}
 
 	PreparedObject struct {
-		vu      modules.VU
-		key     ecdsa.PrivateKey
-		cli     *client.Client
-		bufsize int
+		vu       modules.VU
+		key      ecdsa.PrivateKey
+		cli      *client.Client
+		bufsize  int
+		endpoint string
+
+		bearerToken *bearer.Token
 
-		hdr     object.Object
-		payload []byte
+		result splitResult
 	}
 )
 
 const defaultBufferSize = 64 * 1024
 
+// defaultSplitConcurrency is used by PreparedObject.Put when the caller
+// passes a non-positive concurrency value.
+const defaultSplitConcurrency = 1
+
 func (c *Client) SetBufferSize(size int) {
 	if size < 0 {
 		panic("buffer size must be positive")
@@ -72,6 +84,39 @@ func (c *Client) SetBufferSize(size int) {
 	}
 }
 
+// resolveEndpoint returns the network address of the storage node this
+// Client talks to, querying and caching it via the node's own
+// EndpointInfo RPC on first use. This is what the endpoint tag on
+// tagged metrics is sliced by, so p95/p99 can be broken down per node
+// without requiring the caller to pass the dial target back in.
+func (c *Client) resolveEndpoint() string {
+	c.endpointMu.RLock()
+	endpoint := c.endpoint
+	c.endpointMu.RUnlock()
+	if endpoint != "" {
+		return endpoint
+	}
+
+	c.endpointMu.Lock()
+	defer c.endpointMu.Unlock()
+	if c.endpoint != "" {
+		return c.endpoint
+	}
+
+	res, err := c.cli.EndpointInfo(c.vu.Context(), client.PrmEndpointInfo{})
+	if err != nil {
+		return ""
+	}
+
+	eps := res.NodeInfo().NetworkEndpoints()
+	if len(eps) == 0 {
+		return ""
+	}
+
+	c.endpoint = eps[0]
+	return c.endpoint
+}
+
 func (c *Client) Put(inputContainerID string, headers map[string]string, payload goja.ArrayBuffer) PutResponse {
 	var containerID cid.ID
 	err := containerID.DecodeString(inputContainerID)
@@ -79,13 +124,7 @@ func (c *Client) Put(inputContainerID string, headers map[string]string, payload
 		panic(err)
 	}
 
-	var addr address.Address
-	addr.SetContainerID(containerID)
-
-	tok := c.tok
-	tok.ForVerb(session.VerbObjectPut)
-	tok.ApplyTo(addr)
-	err = tok.Sign(c.key)
+	tok, err := c.sessionFor(containerID, session.VerbObjectPut)
 	if err != nil {
 		panic(err)
 	}
@@ -106,7 +145,7 @@ func (c *Client) Put(inputContainerID string, headers map[string]string, payload
 	o.SetOwnerID(&owner)
 	o.SetAttributes(attrs...)
 
-	resp, err := put(c.vu, c.bufsize, c.cli, &tok, &o, payload.Bytes())
+	resp, err := put(c.vu, c.bufsize, c.cli, &tok, c.bearer(), c.resolveEndpoint(), &o, payload.Bytes())
 	if err != nil {
 		return PutResponse{Success: false, Error: err.Error()}
 	}
@@ -135,14 +174,7 @@ func (c *Client) Get(inputContainerID, inputObjectID string) GetResponse {
 		panic(err)
 	}
 
-	var addr address.Address
-	addr.SetContainerID(containerID)
-	addr.SetObjectID(objectID)
-
-	tok := c.tok
-	tok.ForVerb(session.VerbObjectGet)
-	tok.ApplyTo(addr)
-	err = tok.Sign(c.key)
+	tok, err := c.sessionFor(containerID, session.VerbObjectGet)
 	if err != nil {
 		panic(err)
 	}
@@ -154,12 +186,16 @@ func (c *Client) Get(inputContainerID, inputObjectID string) GetResponse {
 	prmObjectGetInit.ByID(objectID)
 	prmObjectGetInit.FromContainer(containerID)
 	prmObjectGetInit.WithinSession(tok)
+	if bt := c.bearer(); bt != nil {
+		prmObjectGetInit.WithBearerToken(*bt)
+	}
 
 	objectReader, err := c.cli.ObjectGetInit(c.vu.Context(), prmObjectGetInit)
 	if err != nil {
 		stats.Report(c.vu, objGetFails, 1)
 		return GetResponse{Success: false, Error: err.Error()}
 	}
+	initDone := time.Now()
 
 	var o object.Object
 	if !objectReader.ReadHeader(&o) {
@@ -168,7 +204,10 @@ func (c *Client) Get(inputContainerID, inputObjectID string) GetResponse {
 		return GetResponse{Success: false, Error: err.Error()}
 	}
 
+	ensureTaggedMetrics(c.vu)
+
 	n, _ := objectReader.Read(buf)
+	reportGetTTFB(c.vu, c.resolveEndpoint(), containerID, time.Since(initDone))
 	for n > 0 {
 		sz += n
 		n, _ = objectReader.Read(buf)
@@ -180,26 +219,25 @@ func (c *Client) Get(inputContainerID, inputObjectID string) GetResponse {
 		return GetResponse{Success: false, Error: err.Error()}
 	}
 
-	stats.Report(c.vu, objGetDuration, metrics.D(time.Since(start)))
+	duration := time.Since(start)
+	stats.Report(c.vu, objGetDuration, metrics.D(duration))
 	stats.ReportDataReceived(c.vu, float64(sz))
+	reportOpDuration(c.vu, "get", c.resolveEndpoint(), containerID, sz, duration)
 	return GetResponse{Success: true}
 }
 
+// Onsite prepares payload for upload, splitting it into a chain of
+// child objects whenever it exceeds the network's MaxObjectSize,
+// matching the layout produced by neofs-node's
+// object_manager/transformer. It intentionally stops short of
+// assigning IDs or signatures: those depend on attributes, which are
+// only known once PreparedObject.Put is called.
 func (c *Client) Onsite(inputContainerID string, payload goja.ArrayBuffer) PreparedObject {
 	maxObjectSize, epoch, hhDisabled, err := parseNetworkInfo(c.vu.Context(), c.cli)
 	if err != nil {
 		panic(err)
 	}
 	data := payload.Bytes()
-	ln := len(data)
-	if ln > int(maxObjectSize) {
-		// not sure if load test needs object transformation
-		// with parent-child relation; if needs, then replace
-		// this code with the usage of object transformer from
-		// neofs-loader or distribution.
-		msg := fmt.Sprintf("payload size %d is bigger than network limit %d", ln, maxObjectSize)
-		panic(msg)
-	}
 
 	var containerID cid.ID
 	err = containerID.DecodeString(inputContainerID)
@@ -217,30 +255,33 @@ func (c *Client) Onsite(inputContainerID string, payload goja.ArrayBuffer) Prepa
 	obj.SetType(object.TypeRegular)
 	obj.SetContainerID(containerID)
 	obj.SetOwnerID(&owner)
-	obj.SetPayloadSize(uint64(ln))
 	obj.SetCreationEpoch(epoch)
 
-	var sha, hh checksum.Checksum
-	sha.SetSHA256(sha256.Sum256(data))
-	obj.SetPayloadChecksum(sha)
-	if !hhDisabled {
-		hh.SetTillichZemor(tz.Sum(data))
-		obj.SetPayloadHomomorphicHash(hh)
-	}
+	result := transform(*obj, data, maxObjectSize, hhDisabled)
 
 	return PreparedObject{
-		vu:      c.vu,
-		key:     c.key,
-		cli:     c.cli,
-		bufsize: c.bufsize,
+		vu:       c.vu,
+		key:      c.key,
+		cli:      c.cli,
+		bufsize:  c.bufsize,
+		endpoint: c.resolveEndpoint(),
 
-		hdr:     *obj,
-		payload: data,
+		bearerToken: c.bearer(),
+
+		result: result,
 	}
 }
 
-func (p PreparedObject) Put(headers map[string]string) PutResponse {
-	obj := p.hdr
+// Put sets headers as attributes of the (parent) object, finishes
+// assigning IDs and signatures now that they can be computed, and
+// uploads the result. concurrency controls how many split children are
+// uploaded in parallel; values less than 1 fall back to sequential
+// upload. It returns the parent object ID, i.e. the ID a later Get
+// should be addressed to regardless of whether the upload was split.
+func (p PreparedObject) Put(headers map[string]string, concurrency int) PutResponse {
+	if concurrency < 1 {
+		concurrency = defaultSplitConcurrency
+	}
 
 	attrs := make([]object.Attribute, len(headers))
 	ind := 0
@@ -249,27 +290,130 @@ func (p PreparedObject) Put(headers map[string]string) PutResponse {
 		attrs[ind].SetValue(v)
 		ind++
 	}
-	obj.SetAttributes(attrs...)
 
-	id, err := object.CalculateID(&obj)
+	if !p.result.split {
+		// unsplit object: attributes belong to the object itself, and
+		// it is the only thing to sign.
+		part := p.result.children[0]
+		part.hdr.SetAttributes(attrs...)
+
+		id, err := object.CalculateID(&part.hdr)
+		if err != nil {
+			return PutResponse{Success: false, Error: err.Error()}
+		}
+		part.hdr.SetID(id)
+
+		if err = object.CalculateAndSetSignature(p.key, &part.hdr); err != nil {
+			return PutResponse{Success: false, Error: err.Error()}
+		}
+
+		if _, err = put(p.vu, p.bufsize, p.cli, nil, p.bearerToken, p.endpoint, &part.hdr, part.payload); err != nil {
+			return PutResponse{Success: false, Error: err.Error()}
+		}
+
+		return PutResponse{Success: true, ObjectID: id.String()}
+	}
+
+	// split chain: attributes belong to the parent header, which is
+	// never uploaded on its own but is embedded in full in the link
+	// object so a reader resolving the parent ID has something to
+	// read back.
+	parent := p.result.parent
+	parent.SetAttributes(attrs...)
+
+	parentID, err := object.CalculateID(&parent)
 	if err != nil {
 		return PutResponse{Success: false, Error: err.Error()}
 	}
-	obj.SetID(id)
+	parent.SetID(parentID)
 
-	if err = object.CalculateAndSetSignature(p.key, &obj); err != nil {
+	if err = object.CalculateAndSetSignature(p.key, &parent); err != nil {
 		return PutResponse{Success: false, Error: err.Error()}
 	}
+	parentSig := *parent.Signature()
+
+	splitID := object.NewSplitID()
+
+	children := make([]splitPart, len(p.result.children))
+	childIDs := make([]oid.ID, len(p.result.children))
+	var prevID oid.ID
+
+	for i, part := range p.result.children {
+		part.hdr.SetSplitID(splitID)
+		part.hdr.SetParentID(parentID)
+		part.hdr.SetParentSignature(&parentSig)
+		if i > 0 {
+			part.hdr.SetPreviousID(prevID)
+		}
+
+		childID, err := object.CalculateID(&part.hdr)
+		if err != nil {
+			return PutResponse{Success: false, Error: err.Error()}
+		}
+		part.hdr.SetID(childID)
+
+		if err = object.CalculateAndSetSignature(p.key, &part.hdr); err != nil {
+			return PutResponse{Success: false, Error: err.Error()}
+		}
+
+		children[i] = part
+		childIDs[i] = childID
+		prevID = childID
+	}
 
-	_, err = put(p.vu, p.bufsize, p.cli, nil, &obj, p.payload)
+	var link object.Object
+	link.SetContainerID(parent.ContainerID())
+	link.SetOwnerID(parent.OwnerID())
+	link.SetVersion(parent.Version())
+	link.SetCreationEpoch(parent.CreationEpoch())
+	link.SetType(object.TypeRegular)
+	link.SetSplitID(splitID)
+	link.SetParentID(parentID)
+	link.SetParentSignature(&parentSig)
+	link.SetParent(&parent)
+	link.SetChildren(childIDs...)
+
+	linkID, err := object.CalculateID(&link)
 	if err != nil {
 		return PutResponse{Success: false, Error: err.Error()}
 	}
+	link.SetID(linkID)
 
-	return PutResponse{Success: true, ObjectID: id.String()}
+	if err = object.CalculateAndSetSignature(p.key, &link); err != nil {
+		return PutResponse{Success: false, Error: err.Error()}
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	errs := make(chan error, len(children))
+
+	for i := range children {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(part splitPart) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			_, err := put(p.vu, p.bufsize, p.cli, nil, p.bearerToken, p.endpoint, &part.hdr, part.payload)
+			errs <- err
+		}(children[i])
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			return PutResponse{Success: false, Error: err.Error()}
+		}
+	}
+
+	if _, err = put(p.vu, p.bufsize, p.cli, nil, p.bearerToken, p.endpoint, &link, nil); err != nil {
+		return PutResponse{Success: false, Error: err.Error()}
+	}
+
+	return PutResponse{Success: true, ObjectID: parentID.String()}
 }
 
-func put(vu modules.VU, bufSize int, cli *client.Client, tok *session.Object,
+func put(vu modules.VU, bufSize int, cli *client.Client, tok *session.Object, bearerToken *bearer.Token, endpoint string,
 	hdr *object.Object, payload []byte) (*client.ResObjectPut, error) {
 	buf := make([]byte, bufSize)
 	rdr := bytes.NewReader(payload)
@@ -288,6 +432,9 @@ func put(vu modules.VU, bufSize int, cli *client.Client, tok *session.Object,
 	if tok != nil {
 		objectWriter.WithinSession(*tok)
 	}
+	if bearerToken != nil {
+		objectWriter.WithBearerToken(*bearerToken)
+	}
 
 	if !objectWriter.WriteHeader(*hdr) {
 		stats.Report(vu, objPutFails, 1)
@@ -310,7 +457,10 @@ func put(vu modules.VU, bufSize int, cli *client.Client, tok *session.Object,
 	}
 
 	stats.ReportDataSent(vu, float64(sz))
-	stats.Report(vu, objPutDuration, metrics.D(time.Since(start)))
+	duration := time.Since(start)
+	stats.Report(vu, objPutDuration, metrics.D(duration))
+	ensureTaggedMetrics(vu)
+	reportOpDuration(vu, "put", endpoint, hdr.ContainerID(), int(sz), duration)
 
 	return resp, err
 }
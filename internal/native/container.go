@@ -0,0 +1,221 @@
+package native
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+type (
+	PutContainerResponse struct {
+		Success     bool
+		ContainerID string
+		Error       string
+	}
+
+	GetContainerResponse struct {
+		Success     bool
+		ContainerID string
+		OwnerID     string
+		BasicACL    string
+		Attributes  map[string]string
+		Error       string
+	}
+
+	ListContainersResponse struct {
+		Success      bool
+		ContainerIDs []string
+		Error        string
+	}
+
+	DeleteContainerResponse struct {
+		Success bool
+		Error   string
+	}
+)
+
+// containerAwaitPollInterval and containerAwaitTimeout bound how long
+// PutContainer waits for a newly created container to appear on-chain.
+const (
+	containerAwaitPollInterval = 500 * time.Millisecond
+	containerAwaitTimeout      = time.Minute
+)
+
+// aclPresets maps the named presets accepted by the acl param to their
+// SDK values, for callers who'd rather not spell out a basic_acl hex
+// string for one of the common cases.
+var aclPresets = map[string]acl.Basic{
+	"private":           acl.PrivateBasicRule,
+	"public-read":       acl.ReadOnlyBasicRule,
+	"public-read-write": acl.PublicBasicRule,
+}
+
+// PutContainer creates a new container described by params and blocks
+// until it can be fetched back from the network.
+//
+// Recognized keys in params are: acl, placement_policy, name,
+// name_global_scope and basic_acl. basic_acl takes an explicit hex
+// rule string; acl takes one of the named presets in aclPresets. If
+// both are set, basic_acl wins. With neither, the container defaults
+// to private.
+func (c *Client) PutContainer(params map[string]string) PutContainerResponse {
+	var policy netmap.PlacementPolicy
+	if err := policy.DecodeString(params["placement_policy"]); err != nil {
+		return PutContainerResponse{Success: false, Error: err.Error()}
+	}
+
+	var basicACL acl.Basic
+	switch {
+	case params["basic_acl"] != "":
+		if err := basicACL.DecodeString(params["basic_acl"]); err != nil {
+			return PutContainerResponse{Success: false, Error: err.Error()}
+		}
+	case params["acl"] != "":
+		preset, ok := aclPresets[params["acl"]]
+		if !ok {
+			return PutContainerResponse{Success: false, Error: "unrecognized acl preset: " + params["acl"]}
+		}
+		basicACL = preset
+	default:
+		basicACL = acl.PrivateBasicRule
+	}
+
+	var owner user.ID
+	user.IDFromKey(&owner, c.key.PublicKey)
+
+	var cnr container.Container
+	cnr.Init()
+	cnr.SetOwner(owner)
+	cnr.SetPlacementPolicy(policy)
+	cnr.SetBasicACL(basicACL)
+	cnr.SetCreationTime(time.Now())
+
+	if name := params["name"]; name != "" {
+		if params["name_global_scope"] == "true" {
+			container.SetNativeNameWithZone(&cnr, name, container.SysAttributeZoneDefault)
+		} else {
+			container.SetNativeName(&cnr, name)
+		}
+	}
+
+	if err := container.CalculateSignature(&cnr, c.key); err != nil {
+		return PutContainerResponse{Success: false, Error: err.Error()}
+	}
+
+	var prm client.PrmContainerPut
+	prm.WithContainer(cnr)
+
+	res, err := c.cli.ContainerPut(c.vu.Context(), prm)
+	if err != nil {
+		return PutContainerResponse{Success: false, Error: err.Error()}
+	}
+
+	id := res.ID()
+
+	if err := c.awaitContainer(id); err != nil {
+		return PutContainerResponse{Success: false, ContainerID: id.String(), Error: err.Error()}
+	}
+
+	return PutContainerResponse{Success: true, ContainerID: id.String()}
+}
+
+// awaitContainer polls ContainerGet until the container becomes visible
+// or containerAwaitTimeout elapses.
+func (c *Client) awaitContainer(id cid.ID) error {
+	ctx, cancel := context.WithTimeout(c.vu.Context(), containerAwaitTimeout)
+	defer cancel()
+
+	var prm client.PrmContainerGet
+	prm.SetContainer(id)
+
+	for {
+		if _, err := c.cli.ContainerGet(ctx, prm); err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.New("timeout waiting for container to appear on-chain")
+		case <-time.After(containerAwaitPollInterval):
+		}
+	}
+}
+
+// GetContainer fetches the container by id and returns its attributes
+// in a JS-friendly form.
+func (c *Client) GetContainer(inputContainerID string) GetContainerResponse {
+	var id cid.ID
+	if err := id.DecodeString(inputContainerID); err != nil {
+		panic(err)
+	}
+
+	var prm client.PrmContainerGet
+	prm.SetContainer(id)
+
+	res, err := c.cli.ContainerGet(c.vu.Context(), prm)
+	if err != nil {
+		return GetContainerResponse{Success: false, Error: err.Error()}
+	}
+
+	cnr := res.Container()
+
+	attrs := make(map[string]string)
+	cnr.IterateAttributes(func(key, value string) {
+		attrs[key] = value
+	})
+
+	return GetContainerResponse{
+		Success:     true,
+		ContainerID: inputContainerID,
+		OwnerID:     cnr.Owner().String(),
+		BasicACL:    cnr.BasicACL().EncodeToString(),
+		Attributes:  attrs,
+	}
+}
+
+// ListContainers returns the ids of every container owned by the
+// client's key.
+func (c *Client) ListContainers() ListContainersResponse {
+	var owner user.ID
+	user.IDFromKey(&owner, c.key.PublicKey)
+
+	var prm client.PrmContainerList
+	prm.SetAccount(owner)
+
+	res, err := c.cli.ContainerList(c.vu.Context(), prm)
+	if err != nil {
+		return ListContainersResponse{Success: false, Error: err.Error()}
+	}
+
+	ids := make([]string, len(res.Containers()))
+	for i, id := range res.Containers() {
+		ids[i] = id.String()
+	}
+
+	return ListContainersResponse{Success: true, ContainerIDs: ids}
+}
+
+// DeleteContainer removes the container by id, signing the removal
+// request with the client's key.
+func (c *Client) DeleteContainer(inputContainerID string) DeleteContainerResponse {
+	var id cid.ID
+	if err := id.DecodeString(inputContainerID); err != nil {
+		panic(err)
+	}
+
+	var prm client.PrmContainerDelete
+	prm.SetContainer(id)
+
+	if _, err := c.cli.ContainerDelete(c.vu.Context(), prm); err != nil {
+		return DeleteContainerResponse{Success: false, Error: err.Error()}
+	}
+
+	return DeleteContainerResponse{Success: true}
+}
@@ -0,0 +1,98 @@
+package native
+
+import (
+	"sync"
+	"time"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"go.k6.io/k6/js/modules"
+	"go.k6.io/k6/metrics"
+)
+
+// payload_size_bucket labels, matching the ranges operators care about
+// when slicing latency by request size.
+const (
+	bucketUnder4KiB  = "<4KiB"
+	bucketUnder64KiB = "<64KiB"
+	bucketUnder1MiB  = "<1MiB"
+	bucketUnder16MiB = "<16MiB"
+	bucketOver16MiB  = ">=16MiB"
+)
+
+func payloadSizeBucket(n int) string {
+	switch {
+	case n < 4*1024:
+		return bucketUnder4KiB
+	case n < 64*1024:
+		return bucketUnder64KiB
+	case n < 1024*1024:
+		return bucketUnder1MiB
+	case n < 16*1024*1024:
+		return bucketUnder16MiB
+	default:
+		return bucketOver16MiB
+	}
+}
+
+var (
+	taggedMetricsOnce sync.Once
+
+	// opDuration is a single Trend carrying per-call latency, sliced
+	// by endpoint, container_id, payload_size_bucket and op tags so
+	// k6 users can compute p95/p99 for any combination of those
+	// dimensions instead of only the flat neofs_obj_*_duration
+	// counters.
+	opDuration *metrics.Metric
+
+	// getTTFB measures the time between ObjectGetInit returning and
+	// the first payload chunk being read off the stream.
+	getTTFB *metrics.Metric
+)
+
+func ensureTaggedMetrics(vu modules.VU) {
+	taggedMetricsOnce.Do(func() {
+		registry := vu.InitEnv().Registry
+		opDuration, _ = registry.NewMetric("neofs_op_duration", metrics.Trend, metrics.Time)
+		getTTFB, _ = registry.NewMetric("neofs_get_ttfb", metrics.Trend, metrics.Time)
+	})
+}
+
+// reportOpDuration records d as a sample of opDuration tagged with the
+// endpoint, container and payload size bucket the call was made with.
+func reportOpDuration(vu modules.VU, op, endpoint string, containerID cid.ID, payloadSize int, d time.Duration) {
+	reportTagged(vu, opDuration, metrics.D(d), map[string]string{
+		"op":                  op,
+		"endpoint":            endpoint,
+		"container_id":        containerID.String(),
+		"payload_size_bucket": payloadSizeBucket(payloadSize),
+	})
+}
+
+// reportGetTTFB records the Get time-to-first-byte d, tagged the same
+// way as reportOpDuration so it can be sliced alongside it.
+func reportGetTTFB(vu modules.VU, endpoint string, containerID cid.ID, d time.Duration) {
+	reportTagged(vu, getTTFB, metrics.D(d), map[string]string{
+		"endpoint":     endpoint,
+		"container_id": containerID.String(),
+	})
+}
+
+func reportTagged(vu modules.VU, m *metrics.Metric, value float64, tags map[string]string) {
+	if m == nil {
+		return
+	}
+
+	state := vu.State()
+	if state == nil {
+		return
+	}
+
+	metrics.PushIfNotDone(vu.Context(), state.Samples, metrics.Sample{
+		TimeSeries: metrics.TimeSeries{
+			Metric: m,
+			Tags:   state.Tags.GetCurrentValues().WithTagsFromMap(tags),
+		},
+		Time:  time.Now(),
+		Value: value,
+	})
+}
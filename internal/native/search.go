@@ -0,0 +1,272 @@
+package native
+
+import (
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/xk6-neofs/internal/stats"
+	"go.k6.io/k6/metrics"
+)
+
+type (
+	SearchResponse struct {
+		Success   bool
+		ObjectIDs []string
+		Error     string
+	}
+
+	HeadResponse struct {
+		Success    bool
+		ObjectID   string
+		OwnerID    string
+		Attributes map[string]string
+		Error      string
+	}
+
+	DeleteResponse struct {
+		Success     bool
+		TombstoneID string
+		Error       string
+	}
+
+	RangeResponse struct {
+		Success bool
+		Data    []byte
+		Error   string
+	}
+)
+
+// matchTypes maps the JS-friendly filter operators to their SDK
+// counterparts. PRESENT has no dedicated match type of its own: every
+// value has the empty string as a common prefix, so it's expressed as
+// MatchCommonPrefix against an empty value, i.e. "key has some value".
+var matchTypes = map[string]object.SearchMatchType{
+	"EQ":            object.MatchStringEqual,
+	"NE":            object.MatchStringNotEqual,
+	"COMMON_PREFIX": object.MatchCommonPrefix,
+	"PRESENT":       object.MatchCommonPrefix,
+}
+
+// Search looks up objects in containerID matching filters, a map of
+// attribute key to "<operator> <value>" (e.g. {"FileName": "EQ
+// report.csv"}). An empty value part is valid for PRESENT.
+func (c *Client) Search(inputContainerID string, filters map[string]string) SearchResponse {
+	var containerID cid.ID
+	if err := containerID.DecodeString(inputContainerID); err != nil {
+		panic(err)
+	}
+
+	tok, err := c.sessionFor(containerID, session.VerbObjectSearch)
+	if err != nil {
+		panic(err)
+	}
+
+	var fs object.SearchFilters
+	for key, expr := range filters {
+		op, val := splitFilterExpr(expr)
+		mt, ok := matchTypes[op]
+		if !ok {
+			return SearchResponse{Success: false, Error: "unsupported match type: " + op}
+		}
+		if op == "PRESENT" {
+			val = ""
+		}
+		fs.AddFilter(key, val, mt)
+	}
+
+	var prm client.PrmObjectSearch
+	prm.InContainer(containerID)
+	prm.SetFilters(fs)
+	prm.WithinSession(tok)
+	if bt := c.bearer(); bt != nil {
+		prm.WithBearerToken(*bt)
+	}
+
+	stats.Report(c.vu, objSearchTotal, 1)
+	start := time.Now()
+
+	res, err := c.cli.ObjectSearchInit(c.vu.Context(), prm)
+	if err != nil {
+		stats.Report(c.vu, objSearchFails, 1)
+		return SearchResponse{Success: false, Error: err.Error()}
+	}
+	defer res.Close()
+
+	var ids []string
+	res.Iterate(func(id oid.ID) bool {
+		ids = append(ids, id.String())
+		return false
+	})
+
+	if err := res.Close(); err != nil {
+		stats.Report(c.vu, objSearchFails, 1)
+		return SearchResponse{Success: false, Error: err.Error()}
+	}
+
+	duration := time.Since(start)
+	stats.Report(c.vu, objSearchDuration, metrics.D(duration))
+	ensureTaggedMetrics(c.vu)
+	reportOpDuration(c.vu, "search", c.resolveEndpoint(), containerID, 0, duration)
+	return SearchResponse{Success: true, ObjectIDs: ids}
+}
+
+// Head fetches an object's header without its payload.
+func (c *Client) Head(inputContainerID, inputObjectID string) HeadResponse {
+	containerID, objectID := decodeAddress(inputContainerID, inputObjectID)
+
+	tok, err := c.sessionFor(containerID, session.VerbObjectHead)
+	if err != nil {
+		panic(err)
+	}
+
+	var prm client.PrmObjectHead
+	prm.FromContainer(containerID)
+	prm.ByID(objectID)
+	prm.WithinSession(tok)
+	if bt := c.bearer(); bt != nil {
+		prm.WithBearerToken(*bt)
+	}
+
+	stats.Report(c.vu, objHeadTotal, 1)
+	start := time.Now()
+
+	res, err := c.cli.ObjectHead(c.vu.Context(), prm)
+	if err != nil {
+		stats.Report(c.vu, objHeadFails, 1)
+		return HeadResponse{Success: false, Error: err.Error()}
+	}
+
+	var hdr object.Object
+	if !res.ReadHeader(&hdr) {
+		stats.Report(c.vu, objHeadFails, 1)
+		return HeadResponse{Success: false, Error: "empty header in response"}
+	}
+
+	attrs := make(map[string]string)
+	for _, a := range hdr.Attributes() {
+		attrs[a.Key()] = a.Value()
+	}
+
+	duration := time.Since(start)
+	stats.Report(c.vu, objHeadDuration, metrics.D(duration))
+	ensureTaggedMetrics(c.vu)
+	reportOpDuration(c.vu, "head", c.resolveEndpoint(), containerID, 0, duration)
+	return HeadResponse{
+		Success:    true,
+		ObjectID:   objectID.String(),
+		OwnerID:    hdr.OwnerID().String(),
+		Attributes: attrs,
+	}
+}
+
+// Delete removes an object from a container, returning the id of the
+// tombstone object created to mark the removal.
+func (c *Client) Delete(inputContainerID, inputObjectID string) DeleteResponse {
+	containerID, objectID := decodeAddress(inputContainerID, inputObjectID)
+
+	tok, err := c.sessionFor(containerID, session.VerbObjectDelete)
+	if err != nil {
+		panic(err)
+	}
+
+	var prm client.PrmObjectDelete
+	prm.FromContainer(containerID)
+	prm.ByID(objectID)
+	prm.WithinSession(tok)
+	if bt := c.bearer(); bt != nil {
+		prm.WithBearerToken(*bt)
+	}
+
+	stats.Report(c.vu, objDeleteTotal, 1)
+	start := time.Now()
+
+	res, err := c.cli.ObjectDelete(c.vu.Context(), prm)
+	if err != nil {
+		stats.Report(c.vu, objDeleteFails, 1)
+		return DeleteResponse{Success: false, Error: err.Error()}
+	}
+
+	var tombstone oid.ID
+	res.ReadTombstoneID(&tombstone)
+
+	stats.Report(c.vu, objDeleteDuration, metrics.D(time.Since(start)))
+	return DeleteResponse{Success: true, TombstoneID: tombstone.String()}
+}
+
+// GetRange reads a payload slice [offset, offset+length) without
+// fetching the whole object.
+func (c *Client) GetRange(inputContainerID, inputObjectID string, offset, length uint64) RangeResponse {
+	containerID, objectID := decodeAddress(inputContainerID, inputObjectID)
+
+	tok, err := c.sessionFor(containerID, session.VerbObjectRange)
+	if err != nil {
+		panic(err)
+	}
+
+	var prm client.PrmObjectRange
+	prm.FromContainer(containerID)
+	prm.ByID(objectID)
+	prm.SetOffset(offset)
+	prm.SetLength(length)
+	prm.WithinSession(tok)
+	if bt := c.bearer(); bt != nil {
+		prm.WithBearerToken(*bt)
+	}
+
+	stats.Report(c.vu, objRangeTotal, 1)
+	start := time.Now()
+
+	res, err := c.cli.ObjectRangeInit(c.vu.Context(), prm)
+	if err != nil {
+		stats.Report(c.vu, objRangeFails, 1)
+		return RangeResponse{Success: false, Error: err.Error()}
+	}
+
+	buf := make([]byte, c.bufsize)
+	data := make([]byte, 0, length)
+
+	n, _ := res.Read(buf)
+	for n > 0 {
+		data = append(data, buf[:n]...)
+		n, _ = res.Read(buf)
+	}
+
+	if _, err := res.Close(); err != nil {
+		stats.Report(c.vu, objRangeFails, 1)
+		return RangeResponse{Success: false, Error: err.Error()}
+	}
+
+	stats.Report(c.vu, objRangeDuration, metrics.D(time.Since(start)))
+	stats.ReportDataReceived(c.vu, float64(len(data)))
+	return RangeResponse{Success: true, Data: data}
+}
+
+func decodeAddress(inputContainerID, inputObjectID string) (cid.ID, oid.ID) {
+	var containerID cid.ID
+	if err := containerID.DecodeString(inputContainerID); err != nil {
+		panic(err)
+	}
+
+	var objectID oid.ID
+	if err := objectID.DecodeString(inputObjectID); err != nil {
+		panic(err)
+	}
+
+	return containerID, objectID
+}
+
+// splitFilterExpr splits a "<operator> <value>" filter expression,
+// e.g. "EQ report.csv" -> ("EQ", "report.csv"). A bare operator (e.g.
+// "PRESENT") is valid and returns an empty value.
+func splitFilterExpr(expr string) (op, val string) {
+	for i := 0; i < len(expr); i++ {
+		if expr[i] == ' ' {
+			return expr[:i], expr[i+1:]
+		}
+	}
+	return expr, ""
+}
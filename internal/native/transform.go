@@ -0,0 +1,83 @@
+package native
+
+import (
+	"crypto/sha256"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/tzhash/tz"
+)
+
+// splitPart is a single child object of a split chain, everything set
+// except the fields that depend on the (not yet known) parent: SplitID,
+// ParentID, ParentSignature, PreviousID and the object's own
+// ID/signature. Those are filled in by PreparedObject.Put once
+// attributes - and therefore the parent header - are known.
+type splitPart struct {
+	hdr     object.Object
+	payload []byte
+}
+
+// splitResult is what transform produces: either a single unsplit
+// object (parent is the zero value, children has exactly that one
+// part) or a genuine split chain (parent carries the common
+// container/owner/payload checksum, children are ordered chunks still
+// missing their split-chain linkage).
+type splitResult struct {
+	split    bool
+	parent   object.Object
+	children []splitPart
+}
+
+// transform splits data into a chain of child objects no larger than
+// maxObjectSize, matching the layout produced by neofs-node's
+// object_manager/transformer, or returns it unsplit when it already
+// fits in one object. hdr carries the container/owner/version/epoch
+// common to every member of the chain; attributes are intentionally
+// left for the caller to set once known, since they affect the
+// content-addressed IDs of the parent (and, transitively, of every
+// child).
+func transform(hdr object.Object, data []byte, maxObjectSize uint64, hhDisabled bool) splitResult {
+	if uint64(len(data)) <= maxObjectSize {
+		hdr.SetPayloadSize(uint64(len(data)))
+		setPayloadChecksums(&hdr, data, hhDisabled)
+
+		return splitResult{children: []splitPart{{hdr: hdr, payload: data}}}
+	}
+
+	parent := hdr
+	parent.SetPayloadSize(uint64(len(data)))
+	setPayloadChecksums(&parent, data, hhDisabled)
+
+	var children []splitPart
+	for offset := 0; offset < len(data); offset += int(maxObjectSize) {
+		end := offset + int(maxObjectSize)
+		if end > len(data) {
+			end = len(data)
+		}
+		chunk := data[offset:end]
+
+		var child object.Object
+		child.SetContainerID(hdr.ContainerID())
+		child.SetOwnerID(hdr.OwnerID())
+		child.SetVersion(hdr.Version())
+		child.SetCreationEpoch(hdr.CreationEpoch())
+		child.SetType(object.TypeRegular)
+		child.SetPayloadSize(uint64(len(chunk)))
+		setPayloadChecksums(&child, chunk, hhDisabled)
+
+		children = append(children, splitPart{hdr: child, payload: chunk})
+	}
+
+	return splitResult{split: true, parent: parent, children: children}
+}
+
+func setPayloadChecksums(o *object.Object, data []byte, hhDisabled bool) {
+	var sha, hh checksum.Checksum
+	sha.SetSHA256(sha256.Sum256(data))
+	o.SetPayloadChecksum(sha)
+	if !hhDisabled {
+		hh.SetTillichZemor(tz.Sum(data))
+		o.SetPayloadHomomorphicHash(hh)
+	}
+}